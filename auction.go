@@ -1,40 +1,134 @@
 // Package auction implements a computerized auction system where sellers can
 // offer items for sale and buyers can place competing bids. The package
 // provides mechanisms to start an auction, place bids, and determine the
-// winner based on the highest bid. It ensures that bids do not exceed
-// maximum limits set by bidders and that each bid is incremented properly
-// according to predefined rules.
+// winner. In the default ModeAscending bids climb toward a maximum and the
+// highest bid wins; in ModeDescending bids fall toward a minimum and the
+// lowest bid wins. It ensures that bids do not cross those limits and that
+// each bid is incremented properly according to predefined rules. Auctions
+// may optionally be bounded in time, with a Manager available to sweep a
+// group of them and finalize winners as they expire. The auction format
+// itself is pluggable via Strategy: the default EnglishAscending is the
+// open-outcry behavior described above, while SealedFirstPrice and
+// SealedSecondPrice implement sealed-bid formats where bids are hidden from
+// other bidders until the auction closes.
 package auction
 
 import (
-	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 // Storer defines the interface for auction data storage operations.
+// Implementations must make WithTx atomic with respect to the other methods,
+// so that a read-modify-write sequence run inside it is safe under
+// concurrent callers (e.g. a map guarded by a mutex, or a real database
+// transaction).
 type Storer interface {
-	AddBidder(bidder *bidder) error
-	GetBidder(id uuid.UUID) (bidder, error)
-	UpdateBidder(bidder *bidder) error
-	ListBidders() ([]bidder, error)
+	AddBidder(bidder *Bidder) error
+	GetBidder(id uuid.UUID) (Bidder, error)
+	UpdateBidder(bidder *Bidder) error
+	ListBidders() ([]Bidder, error)
+	WithTx(fn func(tx Storer) error) error
+}
+
+// AuctionState represents the lifecycle stage of an auction.
+type AuctionState string
+
+// Set of possible states an auction can be in.
+const (
+	AuctionUpcoming AuctionState = "upcoming"
+	AuctionOngoing  AuctionState = "ongoing"
+	AuctionClosed   AuctionState = "closed"
+)
+
+// AuctionMode determines whether bids climb toward a ceiling or fall toward
+// a floor.
+type AuctionMode string
+
+// Set of possible auction modes. The zero value behaves as ModeAscending.
+const (
+	ModeAscending  AuctionMode = "ascending"
+	ModeDescending AuctionMode = "descending"
+)
+
+// Eligibility gates whether a bidder may bid at all, independent of the bid
+// amount validation PlaceBid/SubmitBid already perform. A typical
+// implementation checks that the bidder holds some minimum locked stake.
+type Eligibility interface {
+	CanBid(id uuid.UUID) error
+}
+
+// Option configures optional Auction behavior at construction time.
+type Option func(*Auction)
+
+// WithEligibility attaches an Eligibility check that every bid must pass.
+func WithEligibility(e Eligibility) Option {
+	return func(a *Auction) {
+		a.eligibility = e
+	}
 }
 
 // Auction holds all the details of a single auction event.
 type Auction struct {
-	storer Storer
-	ID     uuid.UUID
+	mu          sync.Mutex
+	storer      Storer
+	eligibility Eligibility
+	strategy    Strategy
+	ID          uuid.UUID
+	Begin       time.Time
+	End         time.Time
+	State       AuctionState
+	Mode        AuctionMode
 }
 
 // NewAuctionConfig is used to configure a new auction.
 type NewAuctionConfig struct {
 	Bidders []NewBidder
+
+	// Begin and End bound the window during which bids are accepted. When End
+	// is left zero-valued the auction has no time limit and behaves as
+	// before: always open for bidding.
+	Begin time.Time
+	End   time.Time
+
+	// Mode selects whether bids climb toward MaxBid (ModeAscending, the
+	// default) or fall toward MinBid (ModeDescending).
+	Mode AuctionMode
+
+	// MinBidIncrement is applied to any bidder that doesn't set its own
+	// NewBidder.MinBidIncrement.
+	MinBidIncrement float64
+
+	// Strategy selects the auction format: how bids are validated, how
+	// bidders may view each other's bids, and how the winner and price paid
+	// are determined. It defaults to EnglishAscending, the open-outcry
+	// format the package has always implemented.
+	Strategy Strategy
+}
+
+// NewAuction creates a new auction instance backed by an in-memory store.
+func NewAuction(na NewAuctionConfig, opts ...Option) (*Auction, error) {
+	return NewAuctionWithStore(na, NewInMemoryStore(), opts...)
 }
 
-// NewAuction creates a new auction instance from the given parameters.
-func NewAuction(na NewAuctionConfig) (*Auction, error) {
+// NewAuctionWithStore creates a new auction instance backed by the given
+// Storer, allowing callers to plug in a persistent implementation (e.g. one
+// of the stores under auction/store) so bidding state survives restarts.
+func NewAuctionWithStore(na NewAuctionConfig, storer Storer, opts ...Option) (*Auction, error) {
+	// Apply the auction-level MinBidIncrement default to any bidder that
+	// didn't set its own, without mutating the caller's slice.
+	bidders := make([]NewBidder, len(na.Bidders))
+	copy(bidders, na.Bidders)
+	for i := range bidders {
+		if bidders[i].MinBidIncrement == 0 {
+			bidders[i].MinBidIncrement = na.MinBidIncrement
+		}
+	}
+	na.Bidders = bidders
+
 	if err := validateAuctionData(na); err != nil {
 		return nil, fmt.Errorf("invalid auction data: %w", err)
 	}
@@ -42,7 +136,6 @@ func NewAuction(na NewAuctionConfig) (*Auction, error) {
 	// -----------------------------------------------------------------------
 	// Create bidders and add them to the auction.
 
-	storer := NewInMemoryStore()
 	bdrs := toNewBidders(na.Bidders)
 	for _, bdr := range bdrs {
 		if err := storer.AddBidder(bdr); err != nil {
@@ -52,47 +145,127 @@ func NewAuction(na NewAuctionConfig) (*Auction, error) {
 
 	// -----------------------------------------------------------------------
 
+	mode := na.Mode
+	if mode == "" {
+		mode = ModeAscending
+	}
+
+	strategy := na.Strategy
+	if strategy == nil {
+		strategy = EnglishAscending{Mode: mode}
+	}
+
 	auction := Auction{
-		ID:     uuid.New(),
-		storer: storer,
+		ID:       uuid.New(),
+		storer:   storer,
+		Begin:    na.Begin,
+		End:      na.End,
+		Mode:     mode,
+		strategy: strategy,
 	}
+	for _, opt := range opts {
+		opt(&auction)
+	}
+	auction.State = auction.stateAt(time.Now())
 
 	return &auction, nil
 }
 
-// PlaceBid places a bid on the auction.
+// stateAt returns the lifecycle state of the auction at the given time. An
+// auction with a zero-valued End has no time bound and is always ongoing.
+func (a *Auction) stateAt(now time.Time) AuctionState {
+	if a.End.IsZero() {
+		return AuctionOngoing
+	}
+	if now.Before(a.Begin) {
+		return AuctionUpcoming
+	}
+	if now.After(a.End) {
+		return AuctionClosed
+	}
+	return AuctionOngoing
+}
+
+// checkWindow verifies that the auction is currently accepting bids,
+// refreshing its State as a side effect.
+func (a *Auction) checkWindow() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.State = a.stateAt(time.Now())
+
+	switch a.State {
+	case AuctionUpcoming:
+		return fmt.Errorf("%w: opens at %s", ErrAuctionNotStarted, a.Begin)
+	case AuctionClosed:
+		return fmt.Errorf("%w: closed at %s", ErrAuctionExpired, a.End)
+	}
+
+	return nil
+}
+
+// PlaceBid places an auto-incremented bid on the auction: the bidder's
+// CurrentBid moves by its AutoIncrement (up in ModeAscending, down in
+// ModeDescending). It is a thin wrapper over SubmitBid for callers who don't
+// need to supply an explicit amount.
 func (a *Auction) PlaceBid(id uuid.UUID) error {
 	bidder, err := a.storer.GetBidder(id)
 	if err != nil {
 		return fmt.Errorf("failed to get bidder: %w", err)
 	}
 
-	bidAmount := bidder.CurrentBid + bidder.AutoIncrement
+	amount := bidder.CurrentBid + bidder.AutoIncrement
+	if a.Mode == ModeDescending {
+		amount = bidder.CurrentBid - bidder.AutoIncrement
+	}
 
-	// -----------------------------------------------------------------------
-	// Perform validations.
+	return a.SubmitBid(id, amount, time.Now())
+}
 
-	if bidAmount < bidder.StartingBid {
-		return fmt.Errorf("bid amount $%.2f is less than starting bid $%.2f", bidAmount, bidder.StartingBid)
-	}
-	if bidAmount > bidder.MaxBid {
-		return fmt.Errorf("%w: bid amount $%.2f is greater than max bid $%.2f", ErrExceededMaxBid, bidAmount, bidder.MaxBid)
+// SubmitBid places an explicit bid amount on the auction on behalf of
+// bidderID, as if placed at submittedAt. It is the entry point for external
+// clients that compute their own bid amount rather than relying on
+// AutoIncrement. The read-modify-write of the bidder's current bid runs
+// inside a single Storer transaction so that concurrent bids are serialized
+// by the store rather than by an in-process lock, which keeps correctness
+// the same whether the backing Storer is the in-memory map or a persistent
+// database.
+func (a *Auction) SubmitBid(bidderID uuid.UUID, amount float64, submittedAt time.Time) error {
+	if err := a.checkWindow(); err != nil {
+		return err
 	}
-	if bidAmount <= bidder.CurrentBid {
-		return fmt.Errorf("bid amount $%.2f is less than or equal to current bid $%.2f", bidAmount, bidder.CurrentBid)
+	if a.eligibility != nil {
+		if err := a.eligibility.CanBid(bidderID); err != nil {
+			return fmt.Errorf("%w: %w", ErrNotEligible, err)
+		}
 	}
 
-	// -----------------------------------------------------------------------
-	// Updates the bidder current bid.
+	return a.storer.WithTx(func(tx Storer) error {
+		bidder, err := tx.GetBidder(bidderID)
+		if err != nil {
+			return fmt.Errorf("failed to get bidder: %w", err)
+		}
 
-	bidder.CurrentBid = bidAmount
-	bidder.LastBidTime = time.Now()
-	err = a.storer.UpdateBidder(&bidder)
-	if err != nil {
-		return fmt.Errorf("failed to update bidder: %w", err)
-	}
+		// -------------------------------------------------------------------
+		// Validate the submitted amount against the auction's Strategy, which
+		// knows the bid-direction and pricing rules for the format in use
+		// (open-outcry English, or sealed-bid first/second price).
 
-	return nil
+		if err := a.strategy.ValidateBid(bidder, amount); err != nil {
+			return err
+		}
+
+		// -------------------------------------------------------------------
+		// Updates the bidder current bid.
+
+		bidder.CurrentBid = amount
+		bidder.LastBidTime = submittedAt
+		if err := tx.UpdateBidder(&bidder); err != nil {
+			return fmt.Errorf("failed to update bidder: %w", err)
+		}
+
+		return nil
+	})
 }
 
 // Winner represents the winner of the auction.
@@ -101,52 +274,61 @@ type Winner struct {
 	Name string
 }
 
-// DetermineWinner determines the winner of the auction based on the highest current bid.
-// In case of a tie (multiple bidders with the same highest bid), the bidder who placed
-// their bid first (based on LastBidTime) is considered the winner.
-func (a *Auction) DetermineWinner() (Winner, error) {
+// DetermineWinner determines the outcome of the auction under its Strategy:
+// who won, the price they pay (the second-highest bid for SealedSecondPrice,
+// their own bid otherwise), and the bidders ranked from most to least
+// preferred (under the sealed formats, only those that submitted a bid).
+func (a *Auction) DetermineWinner() (Result, error) {
 	bdrs, err := a.storer.ListBidders()
 	if err != nil {
-		return Winner{}, fmt.Errorf("failed to list bidders: %w", err)
+		return Result{}, fmt.Errorf("failed to list bidders: %w", err)
 	}
 
-	var wbdr bidder
-
-	for _, bidder := range bdrs {
-		isWinner := isWinner(&wbdr, &bidder)
-		if isWinner {
-			wbdr = bidder
-		}
+	result, err := a.strategy.DetermineResult(bdrs)
+	if err != nil {
+		return Result{}, err
 	}
 
-	if wbdr.Name == "" {
-		return Winner{}, errors.New("no winner")
-	}
+	return result, nil
+}
 
-	winner := Winner{
-		ID:   wbdr.ID,
-		Name: wbdr.Name,
+// ListBidders returns every bidder in the auction as visible to callerID.
+// Under a ViewAllBids Strategy (the default, open-outcry English auctions)
+// every bidder's CurrentBid is visible to everyone. Under a ViewOwnBid
+// Strategy (the sealed-bid formats) only callerID's own CurrentBid is
+// populated; every other bidder's CurrentBid is zeroed out so bids stay
+// hidden until the auction closes.
+func (a *Auction) ListBidders(callerID uuid.UUID) ([]Bidder, error) {
+	bdrs, err := a.storer.ListBidders()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bidders: %w", err)
 	}
 
-	return winner, nil
-}
+	if a.strategy.Visibility() == ViewAllBids {
+		return bdrs, nil
+	}
 
-// isWinner checks if the provided bidder should replace the current winner.
-// A bidder becomes the new winner if:
-// - There is no current winner.
-// - Their bid is lower than the current winner's bid.
-// - Their bid is the same as the current winner's but was placed earlier.
-func isWinner(currentWinner, bidder *bidder) bool {
-	return currentWinner.Name == "" || // No current winner, so the bidder wins by default.
-		bidder.CurrentBid < currentWinner.CurrentBid || // Bidder has a lower bid.
-		(bidder.CurrentBid == currentWinner.CurrentBid && // Bidder has the same bid but placed it earlier.
-			bidder.LastBidTime.Before(currentWinner.LastBidTime))
+	for i := range bdrs {
+		if bdrs[i].ID != callerID {
+			bdrs[i].CurrentBid = 0
+		}
+	}
+	return bdrs, nil
 }
 
 // validateAuctionData checks that the provided data for a new auction is valid.
 func validateAuctionData(na NewAuctionConfig) error {
 	if len(na.Bidders) <= 1 {
-		return errors.New("auction must have at least two bidders")
+		return fmt.Errorf("%w: auction must have at least two bidders", ErrInvalidLot)
+	}
+	if !na.End.IsZero() && !na.Begin.Before(na.End) {
+		return fmt.Errorf("%w: auction end must be after begin", ErrInvalidLot)
+	}
+	if na.Mode == ModeDescending {
+		switch na.Strategy.(type) {
+		case SealedFirstPrice, SealedSecondPrice:
+			return fmt.Errorf("%w: ModeDescending is not supported by sealed-bid strategies", ErrInvalidLot)
+		}
 	}
 
 	seenIDs := make(map[uuid.UUID]bool)
@@ -155,30 +337,41 @@ func validateAuctionData(na NewAuctionConfig) error {
 		// Check for unique IDs to prevent duplicate bidders.
 
 		if _, exists := seenIDs[bidder.ID]; exists {
-			return fmt.Errorf("duplicate bidder ID detected: %s", bidder.ID)
+			return fmt.Errorf("%w: id %s", ErrDuplicateBidder, bidder.ID)
 		}
 		seenIDs[bidder.ID] = true
 
 		// -----------------------------------------------------------------------
 		// Validate individual bidder data.
 
-		if err := validateBidder(&bidder); err != nil {
+		if err := validateBidder(na.Mode, &bidder); err != nil {
 			return fmt.Errorf("invalid bidder data for bidder ID %s: %w", bidder.ID, err)
 		}
 	}
 	return nil
 }
 
-// validateBidder checks that a bidder's data is valid.
-func validateBidder(b *NewBidder) error {
+// validateBidder checks that a bidder's data is valid for the given mode.
+func validateBidder(mode AuctionMode, b *NewBidder) error {
 	if b.StartingBid <= 0 {
-		return fmt.Errorf("starting bid must be positive, got $%.2f", b.StartingBid)
-	}
-	if b.MaxBid < b.StartingBid {
-		return fmt.Errorf("max bid $%.2f must be greater than or equal to starting bid $%.2f", b.MaxBid, b.StartingBid)
+		return fmt.Errorf("%w: starting bid must be positive, got $%.2f", ErrInvalidLot, b.StartingBid)
 	}
 	if b.AutoIncrement <= 0 {
-		return fmt.Errorf("auto-increment must be positive, got $%.2f", b.AutoIncrement)
+		return fmt.Errorf("%w: auto-increment must be positive, got $%.2f", ErrInvalidLot, b.AutoIncrement)
+	}
+	if b.MinBidIncrement < 0 {
+		return fmt.Errorf("%w: min bid increment must not be negative, got $%.2f", ErrInvalidLot, b.MinBidIncrement)
+	}
+
+	if mode == ModeDescending {
+		if b.MinBid > b.StartingBid {
+			return fmt.Errorf("%w: min bid $%.2f must be less than or equal to starting bid $%.2f", ErrInvalidLot, b.MinBid, b.StartingBid)
+		}
+		return nil
+	}
+
+	if b.MaxBid < b.StartingBid {
+		return fmt.Errorf("%w: max bid $%.2f must be greater than or equal to starting bid $%.2f", ErrInvalidLot, b.MaxBid, b.StartingBid)
 	}
 	return nil
 }
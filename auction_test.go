@@ -2,6 +2,7 @@ package auction
 
 import (
 	"errors"
+	"fmt"
 	"sync"
 	"testing"
 	"time"
@@ -23,6 +24,19 @@ func createBidder(name string, startingBid, maxBid, increment float64) NewBidder
 	}
 }
 
+// createDescendingBidder helps to create a Bidder for a ModeDescending auction.
+func createDescendingBidder(name string, startingBid, minBid, increment float64) NewBidder {
+	return NewBidder{
+		ID:            uuid.New(),
+		Name:          name,
+		StartingBid:   startingBid,
+		MinBid:        minBid,
+		CurrentBid:    startingBid,
+		AutoIncrement: increment,
+		LastBidTime:   time.Time{},
+	}
+}
+
 // TestAuctionScenarios tests multiple auction scenarios.
 func TestAuctionScenarios(t *testing.T) {
 	tests := []struct {
@@ -79,9 +93,9 @@ func TestAuctionScenarios(t *testing.T) {
 				}
 			}
 
-			winner := auction.DetermineWinner()
-			assert.NotNil(t, winner)
-			assert.Equal(t, tt.expectedName, winner.Name, "the expected winner does not match.")
+			result, err := auction.DetermineWinner()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedName, result.Winner.Name, "the expected winner does not match.")
 		})
 	}
 }
@@ -132,8 +146,238 @@ func TestConcurrencyInAuction(t *testing.T) {
 	}
 
 	// Determine the winner and ensure it's a valid winner
-	winner := auction.DetermineWinner()
-	assert.NotNil(t, winner, "There should be a winner")
-	assert.NotEmpty(t, winner.Name, "Winner should have a name")
-	assert.Equal(t, "Pat", winner.Name, "the expected winner does not match.")
+	result, err := auction.DetermineWinner()
+	assert.NoError(t, err, "There should be a winner")
+	assert.NotEmpty(t, result.Winner.Name, "Winner should have a name")
+	assert.Equal(t, "Pat", result.Winner.Name, "the expected winner does not match.")
+}
+
+// TestAuctionWindow verifies that PlaceBid rejects bids placed outside the
+// auction's [Begin, End] window.
+func TestAuctionWindow(t *testing.T) {
+	bidders := []NewBidder{
+		createBidder("Sasha", 50.00, 80.00, 3.00),
+		createBidder("John", 60.00, 82.00, 2.00),
+	}
+
+	t.Run("not started", func(t *testing.T) {
+		auction, err := NewAuction(NewAuctionConfig{
+			Bidders: bidders,
+			Begin:   time.Now().Add(time.Hour),
+			End:     time.Now().Add(2 * time.Hour),
+		})
+		assert.NoError(t, err)
+
+		err = auction.PlaceBid(bidders[0].ID)
+		assert.ErrorIs(t, err, ErrAuctionNotStarted)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		auction, err := NewAuction(NewAuctionConfig{
+			Bidders: bidders,
+			Begin:   time.Now().Add(-2 * time.Hour),
+			End:     time.Now().Add(-time.Hour),
+		})
+		assert.NoError(t, err)
+
+		err = auction.PlaceBid(bidders[0].ID)
+		assert.ErrorIs(t, err, ErrAuctionExpired)
+	})
+
+	t.Run("no window set behaves as before", func(t *testing.T) {
+		auction, err := NewAuction(NewAuctionConfig{Bidders: bidders})
+		assert.NoError(t, err)
+
+		err = auction.PlaceBid(bidders[0].ID)
+		assert.NoError(t, err)
+	})
+}
+
+// TestDescendingAuction verifies that in ModeDescending bids fall toward
+// MinBid and the lowest bidder wins.
+func TestDescendingAuction(t *testing.T) {
+	bidders := []NewBidder{
+		createDescendingBidder("Sasha", 80.00, 50.00, 3.00),
+		createDescendingBidder("John", 82.00, 60.00, 2.00),
+		createDescendingBidder("Pat", 85.00, 55.00, 5.00),
+	}
+
+	auction, err := NewAuction(NewAuctionConfig{Bidders: bidders, Mode: ModeDescending})
+	assert.NoError(t, err)
+
+	active := true
+	for active {
+		active = false
+		for _, bidder := range bidders {
+			err := auction.PlaceBid(bidder.ID)
+			if err != nil && errors.Is(err, ErrBelowMinBid) {
+				continue
+			}
+			if assert.NoError(t, err) {
+				active = true
+			}
+		}
+	}
+
+	result, err := auction.DetermineWinner()
+	assert.NoError(t, err)
+	assert.Equal(t, "Sasha", result.Winner.Name, "the lowest final bid should win")
+}
+
+// stakeEligibility is a sample Eligibility implementation that only allows
+// bidders holding a minimum locked stake to bid.
+type stakeEligibility struct {
+	minStake float64
+	stakes   map[uuid.UUID]float64
+}
+
+func (s stakeEligibility) CanBid(id uuid.UUID) error {
+	if s.stakes[id] < s.minStake {
+		return fmt.Errorf("stake %.2f is below required minimum %.2f", s.stakes[id], s.minStake)
+	}
+	return nil
+}
+
+// TestSubmitBidEligibility verifies that SubmitBid (and, through it,
+// PlaceBid) rejects bids from bidders that fail the configured Eligibility
+// check, and accepts them once eligible.
+func TestSubmitBidEligibility(t *testing.T) {
+	bidders := []NewBidder{
+		createBidder("Sasha", 50.00, 80.00, 3.00),
+		createBidder("John", 60.00, 82.00, 2.00),
+	}
+
+	eligibility := stakeEligibility{
+		minStake: 10.00,
+		stakes: map[uuid.UUID]float64{
+			bidders[1].ID: 10.00,
+		},
+	}
+
+	auction, err := NewAuction(NewAuctionConfig{Bidders: bidders}, WithEligibility(eligibility))
+	assert.NoError(t, err)
+
+	err = auction.PlaceBid(bidders[0].ID)
+	assert.ErrorIs(t, err, ErrNotEligible)
+
+	err = auction.PlaceBid(bidders[1].ID)
+	assert.NoError(t, err)
+}
+
+// TestSubmitBidRejectionPaths verifies that SubmitBid surfaces the same
+// sentinel errors as PlaceBid for out-of-range and too-small bids.
+func TestSubmitBidRejectionPaths(t *testing.T) {
+	bidders := []NewBidder{
+		createBidder("Sasha", 50.00, 80.00, 3.00),
+		createBidder("John", 60.00, 82.00, 2.00),
+	}
+	auction, err := NewAuction(NewAuctionConfig{Bidders: bidders})
+	assert.NoError(t, err)
+
+	err = auction.SubmitBid(bidders[0].ID, 40.00, time.Now())
+	assert.ErrorIs(t, err, ErrBidBelowStart)
+
+	err = auction.SubmitBid(bidders[0].ID, 90.00, time.Now())
+	assert.ErrorIs(t, err, ErrExceededMaxBid)
+
+	err = auction.SubmitBid(bidders[0].ID, 50.00, time.Now())
+	assert.ErrorIs(t, err, ErrBidTooSmall)
+
+	err = auction.SubmitBid(bidders[0].ID, 55.00, time.Now())
+	assert.NoError(t, err)
+}
+
+// TestMinBidIncrement verifies that a bidder whose AutoIncrement is below
+// its configured MinBidIncrement is never able to place a bid.
+func TestMinBidIncrement(t *testing.T) {
+	bidders := []NewBidder{
+		createBidder("Sasha", 50.00, 80.00, 1.00),
+		createBidder("John", 60.00, 82.00, 2.00),
+	}
+	bidders[0].MinBidIncrement = 5.00
+
+	auction, err := NewAuction(NewAuctionConfig{Bidders: bidders})
+	assert.NoError(t, err)
+
+	err = auction.PlaceBid(bidders[0].ID)
+	assert.ErrorIs(t, err, ErrBidTooSmall)
+
+	err = auction.PlaceBid(bidders[1].ID)
+	assert.NoError(t, err)
+}
+
+// TestSealedFirstPriceAuction verifies that in SealedFirstPrice the highest
+// sealed bid wins and pays exactly what it bid, that a bidder may only
+// submit one bid, and that bids stay hidden from other bidders while the
+// auction is open.
+func TestSealedFirstPriceAuction(t *testing.T) {
+	bidders := []NewBidder{
+		createBidder("Sasha", 50.00, 80.00, 3.00),
+		createBidder("John", 60.00, 82.00, 2.00),
+		createBidder("Pat", 55.00, 85.00, 5.00),
+	}
+
+	auction, err := NewAuction(NewAuctionConfig{Bidders: bidders, Strategy: SealedFirstPrice{}})
+	assert.NoError(t, err)
+
+	assert.NoError(t, auction.SubmitBid(bidders[0].ID, 70.00, time.Now()))
+	assert.NoError(t, auction.SubmitBid(bidders[1].ID, 65.00, time.Now()))
+	assert.NoError(t, auction.SubmitBid(bidders[2].ID, 75.00, time.Now()))
+
+	err = auction.SubmitBid(bidders[0].ID, 72.00, time.Now())
+	assert.ErrorIs(t, err, ErrAlreadyBid)
+
+	visible, err := auction.ListBidders(bidders[0].ID)
+	assert.NoError(t, err)
+	for _, b := range visible {
+		if b.ID == bidders[0].ID {
+			assert.Equal(t, 70.00, b.CurrentBid)
+		} else {
+			assert.Zero(t, b.CurrentBid, "other bidders' sealed bids must stay hidden")
+		}
+	}
+
+	result, err := auction.DetermineWinner()
+	assert.NoError(t, err)
+	assert.Equal(t, "Pat", result.Winner.Name)
+	assert.Equal(t, 75.00, result.Price)
+}
+
+// TestSealedSecondPriceAuction verifies that in SealedSecondPrice (Vickrey)
+// the highest sealed bid wins but pays the second-highest bid rather than
+// its own.
+func TestSealedSecondPriceAuction(t *testing.T) {
+	bidders := []NewBidder{
+		createBidder("Sasha", 50.00, 80.00, 3.00),
+		createBidder("John", 60.00, 82.00, 2.00),
+		createBidder("Pat", 55.00, 85.00, 5.00),
+	}
+
+	auction, err := NewAuction(NewAuctionConfig{Bidders: bidders, Strategy: SealedSecondPrice{}})
+	assert.NoError(t, err)
+
+	assert.NoError(t, auction.SubmitBid(bidders[0].ID, 70.00, time.Now()))
+	assert.NoError(t, auction.SubmitBid(bidders[1].ID, 65.00, time.Now()))
+	assert.NoError(t, auction.SubmitBid(bidders[2].ID, 75.00, time.Now()))
+
+	result, err := auction.DetermineWinner()
+	assert.NoError(t, err)
+	assert.Equal(t, "Pat", result.Winner.Name)
+	assert.Equal(t, 70.00, result.Price, "winner should pay the second-highest bid")
+}
+
+// TestSealedModeDescendingRejected verifies that a sealed-bid Strategy
+// combined with ModeDescending is rejected at construction, since the sealed
+// formats have no notion of bids falling toward a floor.
+func TestSealedModeDescendingRejected(t *testing.T) {
+	bidders := []NewBidder{
+		createDescendingBidder("Sasha", 80.00, 50.00, 3.00),
+		createDescendingBidder("John", 82.00, 60.00, 2.00),
+	}
+
+	_, err := NewAuction(NewAuctionConfig{Bidders: bidders, Mode: ModeDescending, Strategy: SealedFirstPrice{}})
+	assert.ErrorIs(t, err, ErrInvalidLot)
+
+	_, err = NewAuction(NewAuctionConfig{Bidders: bidders, Mode: ModeDescending, Strategy: SealedSecondPrice{}})
+	assert.ErrorIs(t, err, ErrInvalidLot)
 }
@@ -1,40 +1,40 @@
 package auction
 
 import (
-	"errors"
 	"time"
 
 	"github.com/google/uuid"
 )
 
-// ErrExceededMaxBid is returned when a bid exceeds the maximum bid allowed by a bidder.
-var ErrExceededMaxBid = errors.New("exceeded maximum bid")
-
 // Bidder represents an individual participant in an auction.
-type bidder struct {
-	ID            uuid.UUID
-	Name          string
-	StartingBid   float64
-	MaxBid        float64
-	CurrentBid    float64
-	AutoIncrement float64
-	LastBidTime   time.Time
+type Bidder struct {
+	ID              uuid.UUID
+	Name            string
+	StartingBid     float64
+	MaxBid          float64 // ceiling a bid may reach in ModeAscending.
+	MinBid          float64 // floor a bid may drop to in ModeDescending.
+	CurrentBid      float64
+	AutoIncrement   float64
+	MinBidIncrement float64 // smallest amount a bid may move by, in either mode.
+	LastBidTime     time.Time
 }
 
 // NewBidder is used to configure a new bidder.
 type NewBidder struct {
-	ID            uuid.UUID
-	Name          string
-	StartingBid   float64
-	MaxBid        float64
-	CurrentBid    float64
-	AutoIncrement float64
-	LastBidTime   time.Time
+	ID              uuid.UUID
+	Name            string
+	StartingBid     float64
+	MaxBid          float64 // ceiling a bid may reach in ModeAscending.
+	MinBid          float64 // floor a bid may drop to in ModeDescending.
+	CurrentBid      float64
+	AutoIncrement   float64
+	MinBidIncrement float64 // smallest amount a bid may move by, in either mode.
+	LastBidTime     time.Time
 }
 
-// toNewBidders converts a slice of NewBidder to a slice of bidder.
-func toNewBidders(bidders []NewBidder) []*bidder {
-	var newBidders []*bidder
+// toNewBidders converts a slice of NewBidder to a slice of Bidder.
+func toNewBidders(bidders []NewBidder) []*Bidder {
+	var newBidders []*Bidder
 	for _, bdr := range bidders {
 		newBidder := toNewBidder(bdr)
 		newBidders = append(newBidders, &newBidder)
@@ -42,15 +42,17 @@ func toNewBidders(bidders []NewBidder) []*bidder {
 	return newBidders
 }
 
-// toNewBidder converts a NewBidder to a bidder.
-func toNewBidder(bdr NewBidder) bidder {
-	return bidder{
-		ID:            bdr.ID,
-		Name:          bdr.Name,
-		StartingBid:   bdr.StartingBid,
-		MaxBid:        bdr.MaxBid,
-		CurrentBid:    bdr.CurrentBid,
-		AutoIncrement: bdr.AutoIncrement,
-		LastBidTime:   bdr.LastBidTime,
+// toNewBidder converts a NewBidder to a Bidder.
+func toNewBidder(bdr NewBidder) Bidder {
+	return Bidder{
+		ID:              bdr.ID,
+		Name:            bdr.Name,
+		StartingBid:     bdr.StartingBid,
+		MaxBid:          bdr.MaxBid,
+		MinBid:          bdr.MinBid,
+		CurrentBid:      bdr.CurrentBid,
+		AutoIncrement:   bdr.AutoIncrement,
+		MinBidIncrement: bdr.MinBidIncrement,
+		LastBidTime:     bdr.LastBidTime,
 	}
 }
@@ -0,0 +1,57 @@
+package auction
+
+import "errors"
+
+// Sentinel errors returned by this package. Callers should prefer
+// errors.Is over matching on error strings.
+var (
+	// ErrExceededMaxBid is returned when an ascending-mode bid exceeds the
+	// maximum bid allowed by a bidder.
+	ErrExceededMaxBid = errors.New("exceeded maximum bid")
+
+	// ErrBelowMinBid is returned when a descending-mode bid drops below the
+	// minimum bid allowed by a bidder.
+	ErrBelowMinBid = errors.New("below minimum bid")
+
+	// ErrBidBelowStart is returned when a bid fails to clear a bidder's
+	// StartingBid (below it in ModeAscending, above it in ModeDescending).
+	ErrBidBelowStart = errors.New("bid does not clear the starting bid")
+
+	// ErrBidTooSmall is returned when a bid does not move far enough away
+	// from the current bid, either because it doesn't improve on it at all
+	// or because it improves by less than MinBidIncrement.
+	ErrBidTooSmall = errors.New("bid increment too small")
+
+	// ErrAuctionNotStarted is returned when a bid is placed before the
+	// auction's Begin time.
+	ErrAuctionNotStarted = errors.New("auction has not started")
+
+	// ErrAuctionExpired is returned when a bid is placed after the
+	// auction's End time.
+	ErrAuctionExpired = errors.New("auction has expired")
+
+	// ErrAuctionNotFound is returned when looking up an auction by ID that
+	// is not registered with a Manager.
+	ErrAuctionNotFound = errors.New("auction not found")
+
+	// ErrBidderNotFound is returned when looking up a bidder by ID that does
+	// not exist in a Storer.
+	ErrBidderNotFound = errors.New("bidder not found")
+
+	// ErrDuplicateBidder is returned when a bidder ID is registered more
+	// than once, whether in a single NewAuctionConfig or in a Storer.
+	ErrDuplicateBidder = errors.New("duplicate bidder")
+
+	// ErrInvalidLot is returned when an auction's own configuration (as
+	// opposed to an individual bidder's) is invalid, e.g. too few bidders or
+	// a malformed time window.
+	ErrInvalidLot = errors.New("invalid auction configuration")
+
+	// ErrNotEligible is returned when an Eligibility check rejects a bidder.
+	ErrNotEligible = errors.New("bidder is not eligible to bid")
+
+	// ErrAlreadyBid is returned when a sealed-bid Strategy (SealedFirstPrice,
+	// SealedSecondPrice) receives a second bid from a bidder that already
+	// submitted one.
+	ErrAlreadyBid = errors.New("sealed bid already submitted")
+)
@@ -0,0 +1,125 @@
+package auction
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Manager supervises the lifecycle of a group of auctions. It periodically
+// sweeps them, transitioning their State and, once an auction's End time has
+// passed, finalizing its winner and publishing it on the Winners channel.
+type Manager struct {
+	mu       sync.Mutex
+	auctions map[uuid.UUID]*Auction
+	interval time.Duration
+	winners  chan Winner
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewManager creates a Manager that sweeps its auctions every interval.
+func NewManager(interval time.Duration) *Manager {
+	return &Manager{
+		auctions: make(map[uuid.UUID]*Auction),
+		interval: interval,
+		winners:  make(chan Winner, 1),
+	}
+}
+
+// Add registers an auction with the manager so it is included in future sweeps.
+func (m *Manager) Add(a *Auction) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.auctions[a.ID] = a
+}
+
+// Get returns the auction registered under id, or ErrAuctionNotFound if it
+// isn't (or has already been finalized and removed by a sweep).
+func (m *Manager) Get(id uuid.UUID) (*Auction, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	a, ok := m.auctions[id]
+	if !ok {
+		return nil, ErrAuctionNotFound
+	}
+	return a, nil
+}
+
+// Winners returns the channel on which finalized winners are published as
+// their auctions expire.
+func (m *Manager) Winners() <-chan Winner {
+	return m.winners
+}
+
+// Start launches the background sweeper. It returns immediately; the sweeper
+// runs until the context is canceled or Stop is called.
+func (m *Manager) Start(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	m.cancel = cancel
+
+	m.wg.Add(1)
+	go m.run(ctx)
+}
+
+// Stop cancels the background sweeper and waits for it to exit.
+func (m *Manager) Stop() {
+	if m.cancel != nil {
+		m.cancel()
+	}
+	m.wg.Wait()
+}
+
+// run is the sweeper loop. It exits when ctx is canceled.
+func (m *Manager) run(ctx context.Context) {
+	defer m.wg.Done()
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.sweep(time.Now())
+		}
+	}
+}
+
+// sweep transitions every registered auction's State and finalizes the ones
+// that have expired, removing them from the manager once finalized. Every
+// auction still registered is unfinalized, so a closed one is finalized even
+// if its State was already set to closed elsewhere (e.g. by a rejected bid).
+func (m *Manager) sweep(now time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for id, a := range m.auctions {
+		a.mu.Lock()
+		a.State = a.stateAt(now)
+		closed := a.State == AuctionClosed
+		a.mu.Unlock()
+
+		if !closed {
+			continue
+		}
+
+		delete(m.auctions, id)
+
+		result, err := a.DetermineWinner()
+		if err != nil {
+			continue
+		}
+
+		select {
+		case m.winners <- Winner{ID: result.Winner.ID, Name: result.Winner.Name}:
+		default:
+			// No one is listening; drop the winner rather than block the sweep.
+		}
+	}
+}
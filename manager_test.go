@@ -0,0 +1,54 @@
+package auction
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestManagerSweepFinalizesExpiredAuction verifies that the Manager
+// transitions an auction to closed and publishes its winner once its End
+// time has passed.
+func TestManagerSweepFinalizesExpiredAuction(t *testing.T) {
+	bidders := []NewBidder{
+		createBidder("Sasha", 50.00, 80.00, 3.00),
+		createBidder("John", 60.00, 82.00, 2.00),
+	}
+
+	auction, err := NewAuction(NewAuctionConfig{
+		Bidders: bidders,
+		Begin:   time.Now().Add(-time.Hour),
+		End:     time.Now().Add(50 * time.Millisecond),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, AuctionOngoing, auction.State)
+
+	mgr := NewManager(time.Millisecond)
+	mgr.Add(auction)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	mgr.Start(ctx)
+	defer mgr.Stop()
+
+	select {
+	case winner := <-mgr.Winners():
+		assert.NotEmpty(t, winner.Name)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for manager to finalize expired auction")
+	}
+
+	assert.Equal(t, AuctionClosed, auction.State)
+}
+
+// TestManagerGetUnknownAuction verifies that Get reports ErrAuctionNotFound
+// for an ID that was never registered.
+func TestManagerGetUnknownAuction(t *testing.T) {
+	mgr := NewManager(time.Minute)
+	_, err := mgr.Get(uuid.New())
+	assert.ErrorIs(t, err, ErrAuctionNotFound)
+}
@@ -1,62 +1,98 @@
 package auction
 
 import (
-	"errors"
 	"sync"
 
 	"github.com/google/uuid"
 )
 
-// InMemoryStore implements the BidderStore interface using an in-memory map.
+// InMemoryStore implements the Storer interface using an in-memory map.
 type InMemoryStore struct {
-	sync.RWMutex
-	bidders map[uuid.UUID]*bidder
+	mu      sync.RWMutex
+	bidders map[uuid.UUID]*Bidder
 }
 
 // NewInMemoryStore creates a new InMemoryStore instance.
 func NewInMemoryStore() *InMemoryStore {
 	return &InMemoryStore{
-		bidders: make(map[uuid.UUID]*bidder),
+		bidders: make(map[uuid.UUID]*Bidder),
 	}
 }
 
 // AddBidder adds a new bidder to the store.
-func (store *InMemoryStore) AddBidder(bidder *bidder) error {
-	store.Lock()
-	defer store.Unlock()
-	if _, exists := store.bidders[bidder.ID]; exists {
-		return errors.New("bidder already exists")
+func (store *InMemoryStore) AddBidder(bidder *Bidder) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	return addBidder(store.bidders, bidder)
+}
+
+// GetBidder retrieves a bidder from the store.
+func (store *InMemoryStore) GetBidder(id uuid.UUID) (Bidder, error) {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	return getBidder(store.bidders, id)
+}
+
+// UpdateBidder updates a bidder in the store.
+func (store *InMemoryStore) UpdateBidder(bidder *Bidder) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	return updateBidder(store.bidders, bidder)
+}
+
+// ListBidders retrieves all bidders from the store.
+func (store *InMemoryStore) ListBidders() ([]Bidder, error) {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	return listBidders(store.bidders), nil
+}
+
+// WithTx runs fn against a view of the store serialized behind store's lock,
+// giving callers a single atomic unit of work (e.g. read-modify-write a bid)
+// analogous to a database transaction.
+func (store *InMemoryStore) WithTx(fn func(tx Storer) error) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	return fn(&inMemoryTx{bidders: store.bidders})
+}
+
+// inMemoryTx exposes the Storer surface against an InMemoryStore's backing
+// map without locking, since its caller (WithTx) already holds the lock.
+type inMemoryTx struct {
+	bidders map[uuid.UUID]*Bidder
+}
+
+func (tx *inMemoryTx) AddBidder(bidder *Bidder) error         { return addBidder(tx.bidders, bidder) }
+func (tx *inMemoryTx) GetBidder(id uuid.UUID) (Bidder, error) { return getBidder(tx.bidders, id) }
+func (tx *inMemoryTx) UpdateBidder(bidder *Bidder) error      { return updateBidder(tx.bidders, bidder) }
+func (tx *inMemoryTx) ListBidders() ([]Bidder, error)         { return listBidders(tx.bidders), nil }
+func (tx *inMemoryTx) WithTx(fn func(tx Storer) error) error  { return fn(tx) }
+
+func addBidder(bidders map[uuid.UUID]*Bidder, bidder *Bidder) error {
+	if _, exists := bidders[bidder.ID]; exists {
+		return ErrDuplicateBidder
 	}
-	store.bidders[bidder.ID] = bidder
+	bidders[bidder.ID] = bidder
 	return nil
 }
 
-// GetBidder retrieves a bidder from the store.
-func (store *InMemoryStore) GetBidder(id uuid.UUID) (bidder, error) {
-	store.RLock()
-	defer store.RUnlock()
-	bdr, exists := store.bidders[id]
+func getBidder(bidders map[uuid.UUID]*Bidder, id uuid.UUID) (Bidder, error) {
+	bdr, exists := bidders[id]
 	if !exists {
-		return bidder{}, errors.New("bidder not found")
+		return Bidder{}, ErrBidderNotFound
 	}
 	return *bdr, nil
 }
 
-// UpdateBidder updates a bidder in the store.
-func (store *InMemoryStore) UpdateBidder(bidder *bidder) error {
-	store.Lock()
-	defer store.Unlock()
-	store.bidders[bidder.ID] = bidder
+func updateBidder(bidders map[uuid.UUID]*Bidder, bidder *Bidder) error {
+	bidders[bidder.ID] = bidder
 	return nil
 }
 
-// ListBidders retrieves all bidders from the store.
-func (store *InMemoryStore) ListBidders() ([]bidder, error) {
-	store.RLock()
-	defer store.RUnlock()
-	bidders := make([]bidder, 0, len(store.bidders))
-	for _, bidder := range store.bidders {
-		bidders = append(bidders, *bidder)
+func listBidders(bidders map[uuid.UUID]*Bidder) []Bidder {
+	out := make([]Bidder, 0, len(bidders))
+	for _, bidder := range bidders {
+		out = append(out, *bidder)
 	}
-	return bidders, nil
+	return out
 }
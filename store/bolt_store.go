@@ -0,0 +1,144 @@
+// Package store provides persistent auction.Storer implementations so that
+// bidding state can survive process restarts, unlike the package-level
+// InMemoryStore.
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/leandrorichard/auction"
+)
+
+var biddersBucket = []byte("bidders")
+
+// BoltStore is a BoltDB-backed implementation of auction.Storer.
+type BoltStore struct {
+	db *bolt.DB
+}
+
+// NewBoltStore opens (creating if necessary) a BoltDB database at path and
+// prepares it to hold bidders.
+func NewBoltStore(path string) (*BoltStore, error) {
+	db, err := bolt.Open(path, 0o600, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bolt db: %w", err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(biddersBucket)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create bidders bucket: %w", err)
+	}
+
+	return &BoltStore{db: db}, nil
+}
+
+// Close releases the underlying BoltDB file handle.
+func (s *BoltStore) Close() error {
+	return s.db.Close()
+}
+
+// AddBidder adds a new bidder to the store.
+func (s *BoltStore) AddBidder(bidder *auction.Bidder) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return addBidderTx(tx, bidder)
+	})
+}
+
+// GetBidder retrieves a bidder from the store.
+func (s *BoltStore) GetBidder(id uuid.UUID) (auction.Bidder, error) {
+	var bidder auction.Bidder
+	err := s.db.View(func(tx *bolt.Tx) error {
+		var err error
+		bidder, err = getBidderTx(tx, id)
+		return err
+	})
+	return bidder, err
+}
+
+// UpdateBidder updates a bidder in the store.
+func (s *BoltStore) UpdateBidder(bidder *auction.Bidder) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return putBidderTx(tx, bidder)
+	})
+}
+
+// ListBidders retrieves all bidders from the store.
+func (s *BoltStore) ListBidders() ([]auction.Bidder, error) {
+	var bidders []auction.Bidder
+	err := s.db.View(func(tx *bolt.Tx) error {
+		var err error
+		bidders, err = listBiddersTx(tx)
+		return err
+	})
+	return bidders, err
+}
+
+// WithTx runs fn against a single BoltDB read-write transaction, giving the
+// caller an atomic read-modify-write unit backed by a real database
+// transaction.
+func (s *BoltStore) WithTx(fn func(tx auction.Storer) error) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return fn(&boltTx{tx: tx})
+	})
+}
+
+// boltTx exposes the auction.Storer surface against an in-flight BoltDB
+// transaction.
+type boltTx struct {
+	tx *bolt.Tx
+}
+
+func (t *boltTx) AddBidder(bidder *auction.Bidder) error         { return addBidderTx(t.tx, bidder) }
+func (t *boltTx) GetBidder(id uuid.UUID) (auction.Bidder, error) { return getBidderTx(t.tx, id) }
+func (t *boltTx) UpdateBidder(bidder *auction.Bidder) error      { return putBidderTx(t.tx, bidder) }
+func (t *boltTx) ListBidders() ([]auction.Bidder, error)         { return listBiddersTx(t.tx) }
+func (t *boltTx) WithTx(fn func(tx auction.Storer) error) error  { return fn(t) }
+
+func addBidderTx(tx *bolt.Tx, bidder *auction.Bidder) error {
+	bucket := tx.Bucket(biddersBucket)
+	if bucket.Get(bidder.ID[:]) != nil {
+		return auction.ErrDuplicateBidder
+	}
+	return putBidderTx(tx, bidder)
+}
+
+func getBidderTx(tx *bolt.Tx, id uuid.UUID) (auction.Bidder, error) {
+	raw := tx.Bucket(biddersBucket).Get(id[:])
+	if raw == nil {
+		return auction.Bidder{}, auction.ErrBidderNotFound
+	}
+
+	var bidder auction.Bidder
+	if err := json.Unmarshal(raw, &bidder); err != nil {
+		return auction.Bidder{}, fmt.Errorf("failed to decode bidder: %w", err)
+	}
+	return bidder, nil
+}
+
+func putBidderTx(tx *bolt.Tx, bidder *auction.Bidder) error {
+	raw, err := json.Marshal(bidder)
+	if err != nil {
+		return fmt.Errorf("failed to encode bidder: %w", err)
+	}
+	return tx.Bucket(biddersBucket).Put(bidder.ID[:], raw)
+}
+
+func listBiddersTx(tx *bolt.Tx) ([]auction.Bidder, error) {
+	var bidders []auction.Bidder
+	err := tx.Bucket(biddersBucket).ForEach(func(_, raw []byte) error {
+		var bidder auction.Bidder
+		if err := json.Unmarshal(raw, &bidder); err != nil {
+			return fmt.Errorf("failed to decode bidder: %w", err)
+		}
+		bidders = append(bidders, bidder)
+		return nil
+	})
+	return bidders, err
+}
@@ -0,0 +1,200 @@
+package store
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/leandrorichard/auction"
+)
+
+// SQLStore is a database/sql-backed implementation of auction.Storer. Callers
+// open the *sql.DB with their driver (e.g. "sqlite" or "sqlite3") and pass it
+// in. Its queries use "?" placeholders, so only drivers that accept that
+// style work; Postgres drivers, which expect "$1"-style placeholders, do not.
+type SQLStore struct {
+	db *sql.DB
+}
+
+const createBiddersTable = `
+CREATE TABLE IF NOT EXISTS bidders (
+	id                 TEXT PRIMARY KEY,
+	name               TEXT NOT NULL,
+	starting_bid       DOUBLE PRECISION NOT NULL,
+	max_bid            DOUBLE PRECISION NOT NULL,
+	min_bid            DOUBLE PRECISION NOT NULL,
+	current_bid        DOUBLE PRECISION NOT NULL,
+	auto_increment     DOUBLE PRECISION NOT NULL,
+	min_bid_increment  DOUBLE PRECISION NOT NULL,
+	last_bid_time      TIMESTAMP NOT NULL
+)`
+
+// NewSQLStore prepares db to hold bidders, creating the backing table if it
+// does not already exist.
+func NewSQLStore(db *sql.DB) (*SQLStore, error) {
+	if _, err := db.Exec(createBiddersTable); err != nil {
+		return nil, fmt.Errorf("failed to create bidders table: %w", err)
+	}
+	return &SQLStore{db: db}, nil
+}
+
+// Close closes the underlying database handle.
+func (s *SQLStore) Close() error {
+	return s.db.Close()
+}
+
+// AddBidder adds a new bidder to the store.
+func (s *SQLStore) AddBidder(bidder *auction.Bidder) error {
+	return addBidderSQL(s.db, bidder)
+}
+
+// GetBidder retrieves a bidder from the store.
+func (s *SQLStore) GetBidder(id uuid.UUID) (auction.Bidder, error) {
+	return getBidderSQL(s.db, id)
+}
+
+// UpdateBidder updates a bidder in the store.
+func (s *SQLStore) UpdateBidder(bidder *auction.Bidder) error {
+	return updateBidderSQL(s.db, bidder)
+}
+
+// ListBidders retrieves all bidders from the store.
+func (s *SQLStore) ListBidders() ([]auction.Bidder, error) {
+	return listBiddersSQL(s.db)
+}
+
+// WithTx runs fn against a single SQL transaction, committing on success and
+// rolling back on error, giving the caller an atomic read-modify-write unit.
+func (s *SQLStore) WithTx(fn func(tx auction.Storer) error) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	if err := fn(&sqlTx{tx: tx}); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("%w (rollback failed: %v)", err, rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// sqlExecer is satisfied by both *sql.DB and *sql.Tx.
+type sqlExecer interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	QueryRow(query string, args ...any) *sql.Row
+	Query(query string, args ...any) (*sql.Rows, error)
+}
+
+// sqlTx exposes the auction.Storer surface against an in-flight SQL
+// transaction.
+type sqlTx struct {
+	tx *sql.Tx
+}
+
+func (t *sqlTx) AddBidder(bidder *auction.Bidder) error         { return addBidderSQL(t.tx, bidder) }
+func (t *sqlTx) GetBidder(id uuid.UUID) (auction.Bidder, error) { return getBidderSQL(t.tx, id) }
+func (t *sqlTx) UpdateBidder(bidder *auction.Bidder) error      { return updateBidderSQL(t.tx, bidder) }
+func (t *sqlTx) ListBidders() ([]auction.Bidder, error)         { return listBiddersSQL(t.tx) }
+func (t *sqlTx) WithTx(fn func(tx auction.Storer) error) error  { return fn(t) }
+
+func addBidderSQL(db sqlExecer, bidder *auction.Bidder) error {
+	const q = `
+INSERT INTO bidders (id, name, starting_bid, max_bid, min_bid, current_bid, auto_increment, min_bid_increment, last_bid_time)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	if _, err := getBidderSQL(db, bidder.ID); err == nil {
+		return auction.ErrDuplicateBidder
+	} else if !errors.Is(err, auction.ErrBidderNotFound) {
+		return err
+	}
+
+	_, err := db.Exec(q,
+		bidder.ID.String(), bidder.Name, bidder.StartingBid, bidder.MaxBid,
+		bidder.MinBid, bidder.CurrentBid, bidder.AutoIncrement, bidder.MinBidIncrement, bidder.LastBidTime)
+	if err != nil {
+		return fmt.Errorf("failed to insert bidder: %w", err)
+	}
+	return nil
+}
+
+func getBidderSQL(db sqlExecer, id uuid.UUID) (auction.Bidder, error) {
+	const q = `
+SELECT id, name, starting_bid, max_bid, min_bid, current_bid, auto_increment, min_bid_increment, last_bid_time
+FROM bidders WHERE id = ?`
+
+	bidder, err := scanBidder(db.QueryRow(q, id.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return auction.Bidder{}, auction.ErrBidderNotFound
+	}
+	return bidder, err
+}
+
+func updateBidderSQL(db sqlExecer, bidder *auction.Bidder) error {
+	const q = `
+UPDATE bidders
+SET name = ?, starting_bid = ?, max_bid = ?, min_bid = ?, current_bid = ?, auto_increment = ?, min_bid_increment = ?, last_bid_time = ?
+WHERE id = ?`
+
+	_, err := db.Exec(q,
+		bidder.Name, bidder.StartingBid, bidder.MaxBid, bidder.MinBid,
+		bidder.CurrentBid, bidder.AutoIncrement, bidder.MinBidIncrement, bidder.LastBidTime, bidder.ID.String())
+	if err != nil {
+		return fmt.Errorf("failed to update bidder: %w", err)
+	}
+	return nil
+}
+
+func listBiddersSQL(db sqlExecer) ([]auction.Bidder, error) {
+	const q = `
+SELECT id, name, starting_bid, max_bid, min_bid, current_bid, auto_increment, min_bid_increment, last_bid_time
+FROM bidders`
+
+	rows, err := db.Query(q)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bidders: %w", err)
+	}
+	defer rows.Close()
+
+	var bidders []auction.Bidder
+	for rows.Next() {
+		bidder, err := scanBidder(rows)
+		if err != nil {
+			return nil, err
+		}
+		bidders = append(bidders, bidder)
+	}
+	return bidders, rows.Err()
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanBidder(row rowScanner) (auction.Bidder, error) {
+	var (
+		bidder auction.Bidder
+		idStr  string
+	)
+
+	err := row.Scan(&idStr, &bidder.Name, &bidder.StartingBid, &bidder.MaxBid,
+		&bidder.MinBid, &bidder.CurrentBid, &bidder.AutoIncrement, &bidder.MinBidIncrement, &bidder.LastBidTime)
+	if err != nil {
+		return auction.Bidder{}, err
+	}
+
+	bidder.ID, err = uuid.Parse(idStr)
+	if err != nil {
+		return auction.Bidder{}, fmt.Errorf("failed to parse bidder id: %w", err)
+	}
+
+	return bidder, nil
+}
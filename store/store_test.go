@@ -0,0 +1,137 @@
+package store
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/leandrorichard/auction"
+)
+
+// runConformanceTests exercises the auction.Storer contract against any
+// implementation, so each backend is held to the same behavior.
+func runConformanceTests(t *testing.T, newStore func(t *testing.T) auction.Storer) {
+	t.Run("add then get round-trips a bidder", func(t *testing.T) {
+		s := newStore(t)
+		bidder := &auction.Bidder{
+			ID:              uuid.New(),
+			Name:            "Sasha",
+			StartingBid:     50,
+			MaxBid:          80,
+			CurrentBid:      50,
+			AutoIncrement:   3,
+			MinBidIncrement: 5,
+			LastBidTime:     time.Now().Truncate(time.Second),
+		}
+
+		assert.NoError(t, s.AddBidder(bidder))
+
+		got, err := s.GetBidder(bidder.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, bidder.Name, got.Name)
+		assert.Equal(t, bidder.CurrentBid, got.CurrentBid)
+		assert.Equal(t, bidder.MinBidIncrement, got.MinBidIncrement)
+	})
+
+	t.Run("add rejects a duplicate id", func(t *testing.T) {
+		s := newStore(t)
+		bidder := &auction.Bidder{ID: uuid.New(), Name: "Sasha", StartingBid: 50, MaxBid: 80, CurrentBid: 50, AutoIncrement: 3}
+
+		assert.NoError(t, s.AddBidder(bidder))
+		assert.Error(t, s.AddBidder(bidder))
+	})
+
+	t.Run("get returns an error for an unknown id", func(t *testing.T) {
+		s := newStore(t)
+		_, err := s.GetBidder(uuid.New())
+		assert.Error(t, err)
+	})
+
+	t.Run("update persists changes", func(t *testing.T) {
+		s := newStore(t)
+		bidder := &auction.Bidder{ID: uuid.New(), Name: "Sasha", StartingBid: 50, MaxBid: 80, CurrentBid: 50, AutoIncrement: 3}
+		assert.NoError(t, s.AddBidder(bidder))
+
+		bidder.CurrentBid = 53
+		bidder.MinBidIncrement = 5
+		assert.NoError(t, s.UpdateBidder(bidder))
+
+		got, err := s.GetBidder(bidder.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, 53.0, got.CurrentBid)
+		assert.Equal(t, 5.0, got.MinBidIncrement)
+	})
+
+	t.Run("list returns every bidder", func(t *testing.T) {
+		s := newStore(t)
+		a := &auction.Bidder{ID: uuid.New(), Name: "Sasha", StartingBid: 50, MaxBid: 80, CurrentBid: 50, AutoIncrement: 3}
+		b := &auction.Bidder{ID: uuid.New(), Name: "John", StartingBid: 60, MaxBid: 82, CurrentBid: 60, AutoIncrement: 2}
+		assert.NoError(t, s.AddBidder(a))
+		assert.NoError(t, s.AddBidder(b))
+
+		bidders, err := s.ListBidders()
+		assert.NoError(t, err)
+		assert.Len(t, bidders, 2)
+	})
+
+	t.Run("WithTx commits on success and rolls back on error", func(t *testing.T) {
+		s := newStore(t)
+		bidder := &auction.Bidder{ID: uuid.New(), Name: "Sasha", StartingBid: 50, MaxBid: 80, CurrentBid: 50, AutoIncrement: 3}
+		assert.NoError(t, s.AddBidder(bidder))
+
+		err := s.WithTx(func(tx auction.Storer) error {
+			bdr, err := tx.GetBidder(bidder.ID)
+			assert.NoError(t, err)
+			bdr.CurrentBid = 53
+			return tx.UpdateBidder(&bdr)
+		})
+		assert.NoError(t, err)
+
+		got, err := s.GetBidder(bidder.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, 53.0, got.CurrentBid)
+
+		boom := assert.AnError
+		err = s.WithTx(func(tx auction.Storer) error {
+			bdr, err := tx.GetBidder(bidder.ID)
+			assert.NoError(t, err)
+			bdr.CurrentBid = 999
+			if err := tx.UpdateBidder(&bdr); err != nil {
+				return err
+			}
+			return boom
+		})
+		assert.ErrorIs(t, err, boom)
+
+		got, err = s.GetBidder(bidder.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, 53.0, got.CurrentBid, "the update should have been rolled back")
+	})
+}
+
+func TestBoltStore(t *testing.T) {
+	runConformanceTests(t, func(t *testing.T) auction.Storer {
+		s, err := NewBoltStore(filepath.Join(t.TempDir(), "auction.db"))
+		assert.NoError(t, err)
+		t.Cleanup(func() { _ = s.Close() })
+		return s
+	})
+}
+
+func TestSQLStore(t *testing.T) {
+	runConformanceTests(t, func(t *testing.T) auction.Storer {
+		db, err := sql.Open("sqlite", ":memory:")
+		assert.NoError(t, err)
+		t.Cleanup(func() { _ = db.Close() })
+
+		s, err := NewSQLStore(db)
+		assert.NoError(t, err)
+		return s
+	})
+}
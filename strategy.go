@@ -0,0 +1,245 @@
+package auction
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Visibility controls which bidders' current bids a caller may see via
+// Auction.ListBidders.
+type Visibility int
+
+const (
+	// ViewAllBids exposes every bidder's CurrentBid to every caller, as in
+	// an open-outcry (English) auction where the running price is public.
+	ViewAllBids Visibility = iota
+
+	// ViewOwnBid exposes only the caller's own CurrentBid; every other
+	// bidder's CurrentBid is zeroed out. Used by sealed-bid formats, where
+	// bids must stay hidden from other bidders until the auction closes.
+	ViewOwnBid
+)
+
+// Result is the outcome of a closed auction: who won, the price they pay,
+// and the bidders ranked from most to least preferred. Under EnglishAscending
+// Ranked holds every bidder; under the sealed formats it holds only the
+// bidders that submitted a bid.
+type Result struct {
+	Winner Bidder
+	Price  float64
+	Ranked []Bidder
+}
+
+// Strategy defines an auction format: how a submitted bid is validated
+// against a bidder's existing state, whether other bidders' current bids may
+// be viewed while the auction is open, and how the winner and price paid are
+// determined once bidding closes.
+type Strategy interface {
+	// Visibility reports whether Auction.ListBidders should expose every
+	// bidder's CurrentBid or only the caller's own.
+	Visibility() Visibility
+
+	// ValidateBid checks whether amount is an acceptable bid for bidder,
+	// given its existing state. It returns the sentinel error describing why
+	// the bid is rejected, if it is.
+	ValidateBid(bidder Bidder, amount float64) error
+
+	// DetermineResult computes the Result from the full set of bidders once
+	// the auction has closed.
+	DetermineResult(bidders []Bidder) (Result, error)
+}
+
+// EnglishAscending is the classic open-outcry format the package has always
+// implemented: every bidder sees the current bid and may keep improving
+// their own (climbing toward MaxBid in ModeAscending, falling toward MinBid
+// in ModeDescending) until no one is willing to go further.
+type EnglishAscending struct {
+	Mode AuctionMode
+}
+
+// Visibility implements Strategy.
+func (s EnglishAscending) Visibility() Visibility { return ViewAllBids }
+
+// ValidateBid implements Strategy. In ModeAscending bids must climb from
+// StartingBid toward MaxBid; in ModeDescending they must fall from
+// StartingBid toward MinBid. Either way the bid must improve on the current
+// one by at least MinBidIncrement.
+func (s EnglishAscending) ValidateBid(bidder Bidder, amount float64) error {
+	var increment float64
+
+	if s.Mode == ModeDescending {
+		increment = bidder.CurrentBid - amount
+
+		if amount > bidder.StartingBid {
+			return fmt.Errorf("%w: bid amount $%.2f is greater than starting bid $%.2f", ErrBidBelowStart, amount, bidder.StartingBid)
+		}
+		if amount < bidder.MinBid {
+			return fmt.Errorf("%w: bid amount $%.2f is less than min bid $%.2f", ErrBelowMinBid, amount, bidder.MinBid)
+		}
+		if amount >= bidder.CurrentBid {
+			return fmt.Errorf("%w: bid amount $%.2f is greater than or equal to current bid $%.2f", ErrBidTooSmall, amount, bidder.CurrentBid)
+		}
+	} else {
+		increment = amount - bidder.CurrentBid
+
+		if amount < bidder.StartingBid {
+			return fmt.Errorf("%w: bid amount $%.2f is less than starting bid $%.2f", ErrBidBelowStart, amount, bidder.StartingBid)
+		}
+		if amount > bidder.MaxBid {
+			return fmt.Errorf("%w: bid amount $%.2f is greater than max bid $%.2f", ErrExceededMaxBid, amount, bidder.MaxBid)
+		}
+		if amount <= bidder.CurrentBid {
+			return fmt.Errorf("%w: bid amount $%.2f is less than or equal to current bid $%.2f", ErrBidTooSmall, amount, bidder.CurrentBid)
+		}
+	}
+
+	if increment < bidder.MinBidIncrement {
+		return fmt.Errorf("%w: bid increment $%.2f is less than minimum increment $%.2f", ErrBidTooSmall, increment, bidder.MinBidIncrement)
+	}
+
+	return nil
+}
+
+// DetermineResult implements Strategy: the winner is the bidder with the
+// highest current bid in ModeAscending, or the lowest in ModeDescending,
+// paying exactly that bid. Ties are broken by whoever placed their bid
+// first.
+func (s EnglishAscending) DetermineResult(bidders []Bidder) (Result, error) {
+	ranked := rank(s.Mode, bidders)
+	if len(ranked) == 0 {
+		return Result{}, errors.New("no winner")
+	}
+
+	return Result{
+		Winner: ranked[0],
+		Price:  ranked[0].CurrentBid,
+		Ranked: ranked,
+	}, nil
+}
+
+// SealedFirstPrice is a sealed-bid format: each bidder submits exactly one
+// bid, blind to every other bidder's, and the highest bidder wins at the
+// price they bid.
+type SealedFirstPrice struct{}
+
+// Visibility implements Strategy.
+func (s SealedFirstPrice) Visibility() Visibility { return ViewOwnBid }
+
+// ValidateBid implements Strategy. A sealed bid must clear the bidder's
+// StartingBid reserve, must not exceed MaxBid when one is set, and may only
+// be submitted once.
+func (s SealedFirstPrice) ValidateBid(bidder Bidder, amount float64) error {
+	if !bidder.LastBidTime.IsZero() {
+		return fmt.Errorf("%w: a sealed bid was already submitted", ErrAlreadyBid)
+	}
+	if amount < bidder.StartingBid {
+		return fmt.Errorf("%w: bid amount $%.2f is less than starting bid $%.2f", ErrBidBelowStart, amount, bidder.StartingBid)
+	}
+	if bidder.MaxBid > 0 && amount > bidder.MaxBid {
+		return fmt.Errorf("%w: bid amount $%.2f is greater than max bid $%.2f", ErrExceededMaxBid, amount, bidder.MaxBid)
+	}
+	return nil
+}
+
+// DetermineResult implements Strategy: the highest sealed bid wins, paying
+// exactly what it bid.
+func (s SealedFirstPrice) DetermineResult(bidders []Bidder) (Result, error) {
+	ranked := rankSealed(bidders)
+	if len(ranked) == 0 {
+		return Result{}, errors.New("no winner")
+	}
+
+	return Result{
+		Winner: ranked[0],
+		Price:  ranked[0].CurrentBid,
+		Ranked: ranked,
+	}, nil
+}
+
+// SealedSecondPrice is a sealed-bid, second-price (Vickrey) format: each
+// bidder submits exactly one bid, blind to every other bidder's, the
+// highest bidder wins, but pays the second-highest bid rather than their
+// own.
+type SealedSecondPrice struct{}
+
+// Visibility implements Strategy.
+func (s SealedSecondPrice) Visibility() Visibility { return ViewOwnBid }
+
+// ValidateBid implements Strategy, applying the same reserve/cap/one-shot
+// rules as SealedFirstPrice.
+func (s SealedSecondPrice) ValidateBid(bidder Bidder, amount float64) error {
+	return SealedFirstPrice{}.ValidateBid(bidder, amount)
+}
+
+// DetermineResult implements Strategy: the highest sealed bid wins, but pays
+// the second-highest bid (or its own, if it was the only bidder).
+func (s SealedSecondPrice) DetermineResult(bidders []Bidder) (Result, error) {
+	ranked := rankSealed(bidders)
+	if len(ranked) == 0 {
+		return Result{}, errors.New("no winner")
+	}
+
+	price := ranked[0].CurrentBid
+	if len(ranked) > 1 {
+		price = ranked[1].CurrentBid
+	}
+
+	return Result{
+		Winner: ranked[0],
+		Price:  price,
+		Ranked: ranked,
+	}, nil
+}
+
+// rank orders bidders from most to least preferred: highest CurrentBid wins
+// in ModeAscending, lowest wins in ModeDescending, and ties are broken by
+// whoever placed their bid first (earliest LastBidTime).
+func rank(mode AuctionMode, bidders []Bidder) []Bidder {
+	remaining := make([]Bidder, len(bidders))
+	copy(remaining, bidders)
+
+	ranked := make([]Bidder, 0, len(bidders))
+	for len(remaining) > 0 {
+		best := 0
+		for i := 1; i < len(remaining); i++ {
+			if isWinner(mode, &remaining[best], &remaining[i]) {
+				best = i
+			}
+		}
+		ranked = append(ranked, remaining[best])
+		remaining = append(remaining[:best], remaining[best+1:]...)
+	}
+	return ranked
+}
+
+// rankSealed orders bidders that have submitted a sealed bid from highest to
+// lowest, excluding any bidder whose LastBidTime is still zero (i.e. never
+// submitted one).
+func rankSealed(bidders []Bidder) []Bidder {
+	submitted := make([]Bidder, 0, len(bidders))
+	for _, b := range bidders {
+		if !b.LastBidTime.IsZero() {
+			submitted = append(submitted, b)
+		}
+	}
+	return rank(ModeAscending, submitted)
+}
+
+// isWinner checks if the provided bidder should replace the current winner.
+// A bidder becomes the new winner if:
+//   - There is no current winner.
+//   - Their bid beats the current winner's bid (highest in ModeAscending,
+//     lowest in ModeDescending).
+//   - Their bid ties the current winner's but was placed earlier.
+func isWinner(mode AuctionMode, currentWinner, bidder *Bidder) bool {
+	if currentWinner.Name == "" { // No current winner, so the bidder wins by default.
+		return true
+	}
+	if bidder.CurrentBid == currentWinner.CurrentBid { // Tie: earliest bid wins.
+		return bidder.LastBidTime.Before(currentWinner.LastBidTime)
+	}
+	if mode == ModeDescending {
+		return bidder.CurrentBid < currentWinner.CurrentBid
+	}
+	return bidder.CurrentBid > currentWinner.CurrentBid
+}